@@ -0,0 +1,87 @@
+// Package basic implements the compute logic for the "basic" zkVM benchmark
+// program (see crates/test-utils/src/program/basic.rs) as a plain Go
+// package with no zkVM or tamago dependency, so it can be unit tested and
+// fuzzed on a normal host instead of only inside a proof. The guest program
+// under tests/zisk/basic_go wraps this package with the zkVM-specific
+// framing and commit calls.
+package basic
+
+import (
+	"fmt"
+
+	"github.com/eth-act/ere/zkvm/codec"
+	"github.com/eth-act/ere/zkvm/result"
+)
+
+// RecordType identifies a BasicProgramInput/BasicProgramOutput entry inside
+// a zkvm/container batch.
+const RecordType uint16 = 1
+
+// BasicProgramInput and BasicProgramOutput round-trip through the CBOR and
+// SSZ codecs (see ssz.go for the hand-written MarshalSSZ/UnmarshalSSZ
+// pair). Protobuf round-tripping is not wired up yet: that needs a .proto
+// schema and generated proto.Message bindings, which this tree has no
+// codegen toolchain to produce, so selecting the protobuf codec for this
+// program fails at Unmarshal/Marshal with "does not implement
+// proto.Message" rather than silently mis-encoding.
+
+// According to crates/test-utils/src/program/basic.rs
+type BasicProgramInput struct {
+	ShouldPanic bool   `cbor:"should_panic"`
+	A           uint8  `cbor:"a"`
+	B           uint16 `cbor:"b"`
+	C           uint32 `cbor:"c"`
+	D           uint64 `cbor:"d"`
+	E           []byte `cbor:"e"`
+}
+
+// According to crates/test-utils/src/program/basic.rs
+type BasicProgramOutput struct {
+	E []byte `cbor:"e"`
+	D uint64 `cbor:"d"`
+	C uint32 `cbor:"c"`
+	B uint16 `cbor:"b"`
+	A uint8  `cbor:"a"`
+}
+
+// UnmarshalInput decodes payload with c into a BasicProgramInput.
+func UnmarshalInput(payload []byte, c codec.Codec) result.Result[BasicProgramInput] {
+	if c == nil {
+		return result.Err[BasicProgramInput](result.KindDeserialize, fmt.Errorf("no codec registered"))
+	}
+	var input BasicProgramInput
+	if err := c.Unmarshal(payload, &input); err != nil {
+		return result.Err[BasicProgramInput](result.KindDeserialize, err)
+	}
+	return result.Ok(input)
+}
+
+// Compute adds 1 to every field of input. ShouldPanic models a
+// guest-triggered abort rather than a deserialization or computation
+// failure.
+func Compute(input BasicProgramInput) result.Result[BasicProgramOutput] {
+	if input.ShouldPanic {
+		return result.Err[BasicProgramOutput](result.KindUserAbort, nil)
+	}
+
+	output := BasicProgramOutput{
+		A: input.A + 1,
+		B: input.B + 1,
+		C: input.C + 1,
+		D: input.D + 1,
+		E: make([]byte, len(input.E)),
+	}
+	for i, b := range input.E {
+		output.E[i] = b + 1
+	}
+
+	return result.Ok(output)
+}
+
+// MarshalOutput encodes output with c.
+func MarshalOutput(output BasicProgramOutput, c codec.Codec) ([]byte, error) {
+	if c == nil {
+		return nil, fmt.Errorf("no codec registered")
+	}
+	return c.Marshal(output)
+}
@@ -0,0 +1,66 @@
+package basic
+
+import (
+	"testing"
+
+	"github.com/eth-act/ere/zkvm/codec"
+	"github.com/fxamacker/cbor/v2"
+)
+
+// FuzzUnmarshalAndCompute feeds raw bytes straight at the CBOR decode path a
+// zkVM proof would otherwise run, so a crashing or malformed input is found
+// by `go test -fuzz` in seconds instead of costing a full proving run.
+func FuzzUnmarshalAndCompute(f *testing.F) {
+	seeds := []BasicProgramInput{
+		{A: 0, B: 0, C: 0, D: 0, E: nil},
+		{A: 255, B: 65535, C: 1<<32 - 1, D: 1<<64 - 1, E: []byte{1, 2, 3}},
+		{ShouldPanic: true, A: 1, B: 2, C: 3, D: 4, E: []byte("abort")},
+	}
+	for _, in := range seeds {
+		encoded, err := cbor.Marshal(in)
+		if err != nil {
+			f.Fatalf("seeding corpus: %v", err)
+		}
+		f.Add(encoded)
+	}
+
+	cborCodec := codec.Get(codec.ContentTypeCBOR)
+
+	f.Fuzz(func(t *testing.T, payload []byte) {
+		inputResult := UnmarshalInput(payload, cborCodec)
+		if inputResult.IsErr() {
+			return
+		}
+		input := inputResult.Unwrap()
+
+		outputResult := Compute(input)
+		if outputResult.IsErr() {
+			if input.ShouldPanic {
+				return
+			}
+			t.Fatalf("compute failed on non-abort input %+v: %v", input, outputResult.Error())
+		}
+
+		output := outputResult.Unwrap()
+		if output.A != input.A+1 {
+			t.Fatalf("A: got %d want %d", output.A, input.A+1)
+		}
+		if output.B != input.B+1 {
+			t.Fatalf("B: got %d want %d", output.B, input.B+1)
+		}
+		if output.C != input.C+1 {
+			t.Fatalf("C: got %d want %d", output.C, input.C+1)
+		}
+		if output.D != input.D+1 {
+			t.Fatalf("D: got %d want %d", output.D, input.D+1)
+		}
+		if len(output.E) != len(input.E) {
+			t.Fatalf("E length: got %d want %d", len(output.E), len(input.E))
+		}
+		for i := range input.E {
+			if output.E[i] != input.E[i]+1 {
+				t.Fatalf("E[%d]: got %d want %d", i, output.E[i], input.E[i]+1)
+			}
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package basic
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// SSZ encodes each type as its fixed-size fields in declaration order,
+// with a variable-length []byte field replaced in the fixed section by a
+// uint32 byte-offset into the data that follows the fixed section. These
+// are hand-written rather than fastssz-generated, but implement the same
+// sszMarshaler/sszUnmarshaler methods zkvm/codec's SSZ codec looks for.
+
+// inputFixedSize is ShouldPanic(1) + A(1) + B(2) + C(4) + D(8) + offset(4).
+const inputFixedSize = 1 + 1 + 2 + 4 + 8 + 4
+
+// MarshalSSZ implements the codec.sszMarshaler interface.
+func (in BasicProgramInput) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, inputFixedSize+len(in.E))
+	if in.ShouldPanic {
+		buf[0] = 1
+	}
+	buf[1] = in.A
+	binary.LittleEndian.PutUint16(buf[2:4], in.B)
+	binary.LittleEndian.PutUint32(buf[4:8], in.C)
+	binary.LittleEndian.PutUint64(buf[8:16], in.D)
+	binary.LittleEndian.PutUint32(buf[16:20], inputFixedSize)
+	copy(buf[inputFixedSize:], in.E)
+	return buf, nil
+}
+
+// UnmarshalSSZ implements the codec.sszUnmarshaler interface.
+func (in *BasicProgramInput) UnmarshalSSZ(data []byte) error {
+	if len(data) < inputFixedSize {
+		return fmt.Errorf("basic: ssz input too short (%d bytes)", len(data))
+	}
+	in.ShouldPanic = data[0] != 0
+	in.A = data[1]
+	in.B = binary.LittleEndian.Uint16(data[2:4])
+	in.C = binary.LittleEndian.Uint32(data[4:8])
+	in.D = binary.LittleEndian.Uint64(data[8:16])
+
+	offset := binary.LittleEndian.Uint32(data[16:20])
+	if offset != inputFixedSize || uint64(offset) > uint64(len(data)) {
+		return fmt.Errorf("basic: ssz input has unexpected variable-section offset %d", offset)
+	}
+	in.E = data[offset:]
+	return nil
+}
+
+// outputFixedSize is offset(4) + D(8) + C(4) + B(2) + A(1).
+const outputFixedSize = 4 + 8 + 4 + 2 + 1
+
+// MarshalSSZ implements the codec.sszMarshaler interface.
+func (out BasicProgramOutput) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, outputFixedSize+len(out.E))
+	binary.LittleEndian.PutUint32(buf[0:4], outputFixedSize)
+	binary.LittleEndian.PutUint64(buf[4:12], out.D)
+	binary.LittleEndian.PutUint32(buf[12:16], out.C)
+	binary.LittleEndian.PutUint16(buf[16:18], out.B)
+	buf[18] = out.A
+	copy(buf[outputFixedSize:], out.E)
+	return buf, nil
+}
+
+// UnmarshalSSZ implements the codec.sszUnmarshaler interface.
+func (out *BasicProgramOutput) UnmarshalSSZ(data []byte) error {
+	if len(data) < outputFixedSize {
+		return fmt.Errorf("basic: ssz output too short (%d bytes)", len(data))
+	}
+	offset := binary.LittleEndian.Uint32(data[0:4])
+	out.D = binary.LittleEndian.Uint64(data[4:12])
+	out.C = binary.LittleEndian.Uint32(data[12:16])
+	out.B = binary.LittleEndian.Uint16(data[16:18])
+	out.A = data[18]
+
+	if offset != outputFixedSize || uint64(offset) > uint64(len(data)) {
+		return fmt.Errorf("basic: ssz output has unexpected variable-section offset %d", offset)
+	}
+	out.E = data[offset:]
+	return nil
+}
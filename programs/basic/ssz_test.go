@@ -0,0 +1,53 @@
+package basic
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/eth-act/ere/zkvm/codec"
+)
+
+func TestSSZInputRoundTrip(t *testing.T) {
+	in := BasicProgramInput{ShouldPanic: true, A: 1, B: 2, C: 3, D: 4, E: []byte("abc")}
+
+	c := codec.Get(codec.ContentTypeSSZ)
+	encoded, err := c.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var decoded BasicProgramInput
+	if err := c.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !bytes.Equal(decoded.E, in.E) || decoded.ShouldPanic != in.ShouldPanic ||
+		decoded.A != in.A || decoded.B != in.B || decoded.C != in.C || decoded.D != in.D {
+		t.Fatalf("got %+v want %+v", decoded, in)
+	}
+}
+
+func TestSSZOutputRoundTrip(t *testing.T) {
+	out := BasicProgramOutput{A: 1, B: 2, C: 3, D: 4, E: []byte("xyz")}
+
+	c := codec.Get(codec.ContentTypeSSZ)
+	encoded, err := c.Marshal(out)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var decoded BasicProgramOutput
+	if err := c.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !bytes.Equal(decoded.E, out.E) || decoded.A != out.A || decoded.B != out.B ||
+		decoded.C != out.C || decoded.D != out.D {
+		t.Fatalf("got %+v want %+v", decoded, out)
+	}
+}
+
+func TestSSZInputRejectsShortData(t *testing.T) {
+	var in BasicProgramInput
+	if err := in.UnmarshalSSZ(make([]byte, inputFixedSize-1)); err == nil {
+		t.Fatal("expected error for truncated ssz input")
+	}
+}
@@ -3,88 +3,72 @@
 package main
 
 import (
-	"encoding/binary"
-	"unsafe"
+	"bytes"
+	"fmt"
+	"io"
 
-	"github.com/eth-act/skunkworks-tama/tamaboards/zkvm"
-	"github.com/eth-act/skunkworks-tama/tamaboards/zkvm/zisk_runtime"
-	"github.com/fxamacker/cbor/v2"
+	"github.com/eth-act/ere/programs/basic"
+	"github.com/eth-act/ere/zkvm/codec"
+	"github.com/eth-act/ere/zkvm/container"
+	"github.com/eth-act/ere/zkvm/frame"
+	"github.com/eth-act/ere/zkvm/result"
 )
 
-// According to crates/test-utils/src/program/basic.rs
-type BasicProgramInput struct {
-	ShouldPanic bool   `cbor:"should_panic"`
-	A           uint8  `cbor:"a"`
-	B           uint16 `cbor:"b"`
-	C           uint32 `cbor:"c"`
-	D           uint64 `cbor:"d"`
-	E           []byte `cbor:"e"`
-}
-
-// According to crates/test-utils/src/program/basic.rs
-type BasicProgramOutput struct {
-	E []byte `cbor:"e"`
-	D uint64 `cbor:"d"`
-	C uint32 `cbor:"c"`
-	B uint16 `cbor:"b"`
-	A uint8  `cbor:"a"`
-}
-
-func readWholeInput() []byte {
-	lengthBytes := make([]byte, 8)
-	src := unsafe.Pointer(uintptr(zkvm.INPUT_ADDR + 8))
-	for i := 0; i < 8; i++ {
-		lengthBytes[i] = *(*byte)(unsafe.Add(src, i))
+// computeBatch decodes payload as a zkvm/container of BasicProgramInput
+// entries, computes each with basic.Compute, and returns an encoded
+// container of the corresponding BasicProgramOutput entries, so a single
+// proof covers a whole batch instead of one guest invocation per input.
+func computeBatch(payload []byte, c codec.Codec) result.Result[[]byte] {
+	it, err := container.NewIterator(bytes.NewReader(payload), int64(len(payload)))
+	if err != nil {
+		return result.Err[[]byte](result.KindDeserialize, err)
 	}
-	length := binary.LittleEndian.Uint64(lengthBytes)
 
-	inputBytes := zisk_runtime.UnsafeReadBytes(int(length))
-	return inputBytes
-}
+	out := container.NewWriter()
+	for {
+		data, recordType, err := it.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return result.Err[[]byte](result.KindDeserialize, err)
+		}
+		if recordType != basic.RecordType {
+			return result.Err[[]byte](result.KindDeserialize, fmt.Errorf("unexpected record type %d", recordType))
+		}
 
-func unmarshalInput(inputBytes []byte) BasicProgramInput {
-	var input BasicProgramInput
-	if err := cbor.Unmarshal(inputBytes[4:], &input); err != nil {
-		panic("failed to deserialize input")
-	}
-	return input
-}
+		inputResult := basic.UnmarshalInput(data, c)
+		if inputResult.IsErr() {
+			return result.Err[[]byte](inputResult.Error().Kind, inputResult.Error().Unwrap())
+		}
 
-func compute(input BasicProgramInput) BasicProgramOutput {
-	if input.ShouldPanic {
-		panic("invalid data")
-	}
+		outputResult := basic.Compute(inputResult.Unwrap())
+		if outputResult.IsErr() {
+			return result.Err[[]byte](outputResult.Error().Kind, outputResult.Error().Unwrap())
+		}
 
-	output := BasicProgramOutput{
-		A: input.A + 1,
-		B: input.B + 1,
-		C: input.C + 1,
-		D: input.D + 1,
-		E: make([]byte, len(input.E)),
-	}
-	for i, b := range input.E {
-		output.E[i] = b + 1
+		encoded, err := basic.MarshalOutput(outputResult.Unwrap(), c)
+		if err != nil {
+			return result.Err[[]byte](result.KindDeserialize, err)
+		}
+		out.Add(basic.RecordType, encoded)
 	}
 
-	return output
+	return result.Ok(out.Bytes())
 }
 
-func marshalOutput(output BasicProgramOutput) []byte {
-	outputBytes, err := cbor.Marshal(output)
+func main() {
+	payload, hdr, err := frame.ReadFrame()
 	if err != nil {
-		panic("failed to serialize output")
+		result.CommitErr(result.KindDeserialize)
+		return
 	}
-	return outputBytes
-}
+	contentType := uint16(hdr.Flags)
+	c := codec.Get(contentType)
 
-func writeWholeOutput(outputBytes []byte) {
-	zisk_runtime.CommitBytes(outputBytes)
-}
-
-func main() {
-	inputBytes := readWholeInput()
-	input := unmarshalInput(inputBytes)
-	output := compute(input)
-	outputBytes := marshalOutput(output)
-	writeWholeOutput(outputBytes)
+	batchResult := computeBatch(payload, c)
+	marshal := func(out []byte) ([]byte, error) {
+		return frame.WriteFrame(out, uint8(contentType)), nil
+	}
+	result.CommitResult(batchResult, marshal)
 }
@@ -0,0 +1,14 @@
+package codec
+
+import "github.com/fxamacker/cbor/v2"
+
+// ContentTypeCBOR identifies the CBOR codec in a frame's Flags byte.
+const ContentTypeCBOR uint16 = 1
+
+type cborCodec struct{}
+
+func (cborCodec) Marshal(v any) ([]byte, error)      { return cbor.Marshal(v) }
+func (cborCodec) Unmarshal(data []byte, v any) error { return cbor.Unmarshal(data, v) }
+func (cborCodec) ContentType() uint16                { return ContentTypeCBOR }
+
+func init() { register(cborCodec{}) }
@@ -0,0 +1,25 @@
+// Package codec lets a zkVM guest pick its wire format at runtime instead of
+// compile time. The frame header's Flags byte (see zkvm/frame) carries a
+// Codec's ContentType, so a single guest binary can be exercised against
+// CBOR, SSZ, and protobuf-encoded inputs for per-codec proof-cost
+// benchmarking.
+package codec
+
+// Codec marshals and unmarshals guest values to a specific wire format.
+type Codec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+	ContentType() uint16
+}
+
+var registry = map[uint16]Codec{}
+
+func register(c Codec) {
+	registry[c.ContentType()] = c
+}
+
+// Get returns the Codec registered for contentType, or nil if none is
+// registered.
+func Get(contentType uint16) Codec {
+	return registry[contentType]
+}
@@ -0,0 +1,62 @@
+package codec
+
+import "testing"
+
+type sample struct {
+	A uint8 `cbor:"a"`
+}
+
+func TestCBORRoundTrip(t *testing.T) {
+	c := Get(ContentTypeCBOR)
+	if c == nil {
+		t.Fatal("CBOR codec not registered")
+	}
+
+	encoded, err := c.Marshal(sample{A: 7})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var decoded sample
+	if err := c.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if decoded.A != 7 {
+		t.Fatalf("A: got %d want 7", decoded.A)
+	}
+}
+
+// sample implements neither the fastssz-generated methods nor proto.Message,
+// so the SSZ and protobuf codecs must reject it rather than silently
+// mis-encoding it.
+func TestSSZRejectsUnsupportedType(t *testing.T) {
+	c := Get(ContentTypeSSZ)
+	if c == nil {
+		t.Fatal("SSZ codec not registered")
+	}
+	if _, err := c.Marshal(sample{}); err == nil {
+		t.Fatal("expected error: sample has no generated MarshalSSZ")
+	}
+	if err := c.Unmarshal(nil, &sample{}); err == nil {
+		t.Fatal("expected error: sample has no generated UnmarshalSSZ")
+	}
+}
+
+func TestProtobufRejectsUnsupportedType(t *testing.T) {
+	c := Get(ContentTypeProtobuf)
+	if c == nil {
+		t.Fatal("protobuf codec not registered")
+	}
+	if _, err := c.Marshal(sample{}); err == nil {
+		t.Fatal("expected error: sample does not implement proto.Message")
+	}
+	if err := c.Unmarshal(nil, &sample{}); err == nil {
+		t.Fatal("expected error: sample does not implement proto.Message")
+	}
+}
+
+func TestGetUnknownContentType(t *testing.T) {
+	if c := Get(0xffff); c != nil {
+		t.Fatalf("expected nil codec for an unregistered content type, got %T", c)
+	}
+}
@@ -0,0 +1,32 @@
+package codec
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ContentTypeProtobuf identifies the protobuf codec in a frame's Flags byte.
+const ContentTypeProtobuf uint16 = 3
+
+type protobufCodec struct{}
+
+func (protobufCodec) Marshal(v any) ([]byte, error) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("codec: %T does not implement proto.Message", v)
+	}
+	return proto.Marshal(m)
+}
+
+func (protobufCodec) Unmarshal(data []byte, v any) error {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("codec: %T does not implement proto.Message", v)
+	}
+	return proto.Unmarshal(data, m)
+}
+
+func (protobufCodec) ContentType() uint16 { return ContentTypeProtobuf }
+
+func init() { register(protobufCodec{}) }
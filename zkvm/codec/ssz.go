@@ -0,0 +1,39 @@
+package codec
+
+import "fmt"
+
+// ContentTypeSSZ identifies the SSZ codec in a frame's Flags byte.
+const ContentTypeSSZ uint16 = 2
+
+// sszMarshaler/sszUnmarshaler mirror the methods fastssz generates, so this
+// codec works with any type that has SSZ support generated for it without
+// pulling in a direct dependency.
+type sszMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+type sszUnmarshaler interface {
+	UnmarshalSSZ([]byte) error
+}
+
+type sszCodec struct{}
+
+func (sszCodec) Marshal(v any) ([]byte, error) {
+	m, ok := v.(sszMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("codec: %T has no generated MarshalSSZ", v)
+	}
+	return m.MarshalSSZ()
+}
+
+func (sszCodec) Unmarshal(data []byte, v any) error {
+	u, ok := v.(sszUnmarshaler)
+	if !ok {
+		return fmt.Errorf("codec: %T has no generated UnmarshalSSZ", v)
+	}
+	return u.UnmarshalSSZ(data)
+}
+
+func (sszCodec) ContentType() uint16 { return ContentTypeSSZ }
+
+func init() { register(sszCodec{}) }
@@ -0,0 +1,172 @@
+// Package container implements a simple typed-record container format for
+// batching zkVM runs, borrowed from the era/e2store record-stream idea: a
+// sequence of [type:2][length:4][data:length] entries followed by an index
+// record (entry count + absolute offsets) and an 8-byte trailer pointing at
+// that index, so proving N inputs costs one VM boot instead of N.
+package container
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// indexType marks the trailing index record, distinguishing it from the
+// caller-defined record types of the actual entries.
+const indexType uint16 = 0xffff
+
+const entryHeaderSize = 2 + 4 // type + length
+const trailerSize = 8         // absolute offset of the index record
+
+// Writer builds a container on the host: entries are appended in order, and
+// Bytes finalizes the stream with its index and trailer.
+type Writer struct {
+	buf     []byte
+	offsets []uint64
+}
+
+// NewWriter returns an empty Writer.
+func NewWriter() *Writer {
+	return &Writer{}
+}
+
+// Add appends a record of the given type to the container.
+func (w *Writer) Add(recordType uint16, data []byte) {
+	w.offsets = append(w.offsets, uint64(len(w.buf)))
+
+	header := make([]byte, entryHeaderSize)
+	binary.LittleEndian.PutUint16(header[0:2], recordType)
+	binary.LittleEndian.PutUint32(header[2:6], uint32(len(data)))
+
+	w.buf = append(w.buf, header...)
+	w.buf = append(w.buf, data...)
+}
+
+// Bytes returns the finalized container: every added entry, followed by the
+// index record and trailer.
+func (w *Writer) Bytes() []byte {
+	indexOffset := uint64(len(w.buf))
+
+	indexData := make([]byte, 8+8*len(w.offsets))
+	binary.LittleEndian.PutUint64(indexData[0:8], uint64(len(w.offsets)))
+	for i, off := range w.offsets {
+		binary.LittleEndian.PutUint64(indexData[8+i*8:16+i*8], off)
+	}
+
+	header := make([]byte, entryHeaderSize)
+	binary.LittleEndian.PutUint16(header[0:2], indexType)
+	binary.LittleEndian.PutUint32(header[2:6], uint32(len(indexData)))
+
+	out := make([]byte, 0, len(w.buf)+len(header)+len(indexData)+trailerSize)
+	out = append(out, w.buf...)
+	out = append(out, header...)
+	out = append(out, indexData...)
+
+	trailer := make([]byte, trailerSize)
+	binary.LittleEndian.PutUint64(trailer, indexOffset)
+	return append(out, trailer...)
+}
+
+// Iterator provides random access into a container via io.ReaderAt, as
+// produced by Writer.
+type Iterator struct {
+	r       io.ReaderAt
+	size    int64
+	offsets []int64
+	cursor  int
+}
+
+// NewIterator reads the trailer and index at the end of a container of size
+// bytes, readable through r.
+func NewIterator(r io.ReaderAt, size int64) (*Iterator, error) {
+	if size < trailerSize {
+		return nil, fmt.Errorf("container: too small (%d bytes)", size)
+	}
+
+	trailer := make([]byte, trailerSize)
+	if _, err := r.ReadAt(trailer, size-trailerSize); err != nil {
+		return nil, fmt.Errorf("container: reading trailer: %w", err)
+	}
+	indexOffset := int64(binary.LittleEndian.Uint64(trailer))
+
+	header := make([]byte, entryHeaderSize)
+	if _, err := r.ReadAt(header, indexOffset); err != nil {
+		return nil, fmt.Errorf("container: reading index header: %w", err)
+	}
+	if recordType := binary.LittleEndian.Uint16(header[0:2]); recordType != indexType {
+		return nil, fmt.Errorf("container: expected index record (type %d), got %d", indexType, recordType)
+	}
+	length := binary.LittleEndian.Uint32(header[2:6])
+
+	remaining := size - indexOffset - entryHeaderSize
+	if remaining < 0 || int64(length) > remaining {
+		return nil, fmt.Errorf("container: index length %d exceeds %d remaining bytes", length, remaining)
+	}
+
+	indexData := make([]byte, length)
+	if _, err := r.ReadAt(indexData, indexOffset+entryHeaderSize); err != nil {
+		return nil, fmt.Errorf("container: reading index: %w", err)
+	}
+
+	if len(indexData) < 8 {
+		return nil, fmt.Errorf("container: index too short (%d bytes)", len(indexData))
+	}
+	count := binary.LittleEndian.Uint64(indexData[0:8])
+	maxCount := uint64(len(indexData)-8) / 8
+	if count > maxCount {
+		return nil, fmt.Errorf("container: index length %d inconsistent with entry count %d", len(indexData), count)
+	}
+	if uint64(len(indexData)) != 8+8*count {
+		return nil, fmt.Errorf("container: index length %d inconsistent with entry count %d", len(indexData), count)
+	}
+
+	offsets := make([]int64, count)
+	for i := range offsets {
+		offsets[i] = int64(binary.LittleEndian.Uint64(indexData[8+i*8 : 16+i*8]))
+	}
+
+	return &Iterator{r: r, size: size, offsets: offsets}, nil
+}
+
+// Len returns the number of entries in the container (excluding the index).
+func (it *Iterator) Len() int { return len(it.offsets) }
+
+// Seek moves the cursor to entry i, so the next Next() call returns it. i
+// may equal Len(), positioning the cursor past the last entry so the next
+// Next() returns io.EOF. It returns an error instead of moving the cursor
+// if i is out of [0, Len()].
+func (it *Iterator) Seek(i int) error {
+	if i < 0 || i > len(it.offsets) {
+		return fmt.Errorf("container: seek index %d out of range [0, %d]", i, len(it.offsets))
+	}
+	it.cursor = i
+	return nil
+}
+
+// Next returns the data and type of the entry at the current cursor and
+// advances it, returning io.EOF once every entry has been consumed.
+func (it *Iterator) Next() ([]byte, uint16, error) {
+	if it.cursor < 0 || it.cursor >= len(it.offsets) {
+		return nil, 0, io.EOF
+	}
+
+	header := make([]byte, entryHeaderSize)
+	if _, err := it.r.ReadAt(header, it.offsets[it.cursor]); err != nil {
+		return nil, 0, fmt.Errorf("container: reading entry %d header: %w", it.cursor, err)
+	}
+	recordType := binary.LittleEndian.Uint16(header[0:2])
+	length := binary.LittleEndian.Uint32(header[2:6])
+
+	remaining := it.size - it.offsets[it.cursor] - entryHeaderSize
+	if remaining < 0 || int64(length) > remaining {
+		return nil, 0, fmt.Errorf("container: entry %d length %d exceeds %d remaining bytes", it.cursor, length, remaining)
+	}
+
+	data := make([]byte, length)
+	if _, err := it.r.ReadAt(data, it.offsets[it.cursor]+entryHeaderSize); err != nil {
+		return nil, 0, fmt.Errorf("container: reading entry %d data: %w", it.cursor, err)
+	}
+
+	it.cursor++
+	return data, recordType, nil
+}
@@ -0,0 +1,209 @@
+package container
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+)
+
+func TestWriterIteratorRoundTrip(t *testing.T) {
+	w := NewWriter()
+	w.Add(1, []byte("a"))
+	w.Add(2, []byte("bb"))
+	w.Add(1, []byte("ccc"))
+
+	encoded := w.Bytes()
+	it, err := NewIterator(bytes.NewReader(encoded), int64(len(encoded)))
+	if err != nil {
+		t.Fatalf("NewIterator: %v", err)
+	}
+	if it.Len() != 3 {
+		t.Fatalf("Len: got %d want 3", it.Len())
+	}
+
+	want := []struct {
+		data string
+		typ  uint16
+	}{
+		{"a", 1},
+		{"bb", 2},
+		{"ccc", 1},
+	}
+	for i, w := range want {
+		data, typ, err := it.Next()
+		if err != nil {
+			t.Fatalf("Next(%d): %v", i, err)
+		}
+		if string(data) != w.data || typ != w.typ {
+			t.Fatalf("entry %d: got (%q, %d) want (%q, %d)", i, data, typ, w.data, w.typ)
+		}
+	}
+	if _, _, err := it.Next(); err != io.EOF {
+		t.Fatalf("expected io.EOF after last entry, got %v", err)
+	}
+}
+
+func TestIteratorSeek(t *testing.T) {
+	w := NewWriter()
+	w.Add(1, []byte("a"))
+	w.Add(1, []byte("b"))
+	encoded := w.Bytes()
+
+	it, err := NewIterator(bytes.NewReader(encoded), int64(len(encoded)))
+	if err != nil {
+		t.Fatalf("NewIterator: %v", err)
+	}
+
+	if err := it.Seek(1); err != nil {
+		t.Fatalf("Seek: %v", err)
+	}
+	data, _, err := it.Next()
+	if err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+	if string(data) != "b" {
+		t.Fatalf("got %q want %q", data, "b")
+	}
+}
+
+func TestIteratorSeekOutOfRange(t *testing.T) {
+	w := NewWriter()
+	w.Add(1, []byte("a"))
+	encoded := w.Bytes()
+
+	it, err := NewIterator(bytes.NewReader(encoded), int64(len(encoded)))
+	if err != nil {
+		t.Fatalf("NewIterator: %v", err)
+	}
+
+	if err := it.Seek(-1); err == nil {
+		t.Fatal("expected error seeking to a negative index")
+	}
+	if err := it.Seek(2); err == nil {
+		t.Fatal("expected error seeking past Len()")
+	}
+
+	// Seek(Len()) is valid and positions the cursor past the last entry.
+	if err := it.Seek(1); err != nil {
+		t.Fatalf("Seek(Len()): %v", err)
+	}
+	if _, _, err := it.Next(); err != io.EOF {
+		t.Fatalf("expected io.EOF at Len(), got %v", err)
+	}
+}
+
+// TestNewIteratorRejectsInconsistentIndex builds an index record whose
+// declared length doesn't match count*8+8 (count claims 2 offsets but only
+// 1 is present) and asserts NewIterator returns an error instead of
+// panicking on an out-of-range slice.
+func TestNewIteratorRejectsInconsistentIndex(t *testing.T) {
+	var buf bytes.Buffer
+
+	entryHeader := make([]byte, entryHeaderSize)
+	binary.LittleEndian.PutUint16(entryHeader[0:2], 1)
+	binary.LittleEndian.PutUint32(entryHeader[2:6], 1)
+	buf.Write(entryHeader)
+	buf.WriteByte('a')
+
+	indexOffset := uint64(buf.Len())
+
+	indexData := make([]byte, 16)
+	binary.LittleEndian.PutUint64(indexData[0:8], 2)  // claims 2 offsets...
+	binary.LittleEndian.PutUint64(indexData[8:16], 0) // ...but only 1 follows
+
+	indexHeader := make([]byte, entryHeaderSize)
+	binary.LittleEndian.PutUint16(indexHeader[0:2], indexType)
+	binary.LittleEndian.PutUint32(indexHeader[2:6], uint32(len(indexData)))
+	buf.Write(indexHeader)
+	buf.Write(indexData)
+
+	trailer := make([]byte, trailerSize)
+	binary.LittleEndian.PutUint64(trailer, indexOffset)
+	buf.Write(trailer)
+
+	if _, err := NewIterator(bytes.NewReader(buf.Bytes()), int64(buf.Len())); err == nil {
+		t.Fatal("expected error for inconsistent index length/count")
+	}
+}
+
+func TestNewIteratorTooSmall(t *testing.T) {
+	if _, err := NewIterator(bytes.NewReader([]byte{1, 2, 3}), 3); err == nil {
+		t.Fatal("expected error for a container smaller than the trailer")
+	}
+}
+
+// TestNewIteratorRejectsOverflowingCount builds an index record with no
+// entries but a declared count large enough that count*8 wraps around
+// uint64 back to a value consistent with the (empty) index length, and
+// asserts NewIterator returns an error instead of overflowing the bounds
+// check and panicking in make([]int64, count).
+func TestNewIteratorRejectsOverflowingCount(t *testing.T) {
+	var buf bytes.Buffer
+
+	indexOffset := uint64(buf.Len())
+
+	indexData := make([]byte, 8)
+	binary.LittleEndian.PutUint64(indexData[0:8], 1<<61) // 8*count wraps to 0 mod 2^64
+
+	indexHeader := make([]byte, entryHeaderSize)
+	binary.LittleEndian.PutUint16(indexHeader[0:2], indexType)
+	binary.LittleEndian.PutUint32(indexHeader[2:6], uint32(len(indexData)))
+	buf.Write(indexHeader)
+	buf.Write(indexData)
+
+	trailer := make([]byte, trailerSize)
+	binary.LittleEndian.PutUint64(trailer, indexOffset)
+	buf.Write(trailer)
+
+	if _, err := NewIterator(bytes.NewReader(buf.Bytes()), int64(buf.Len())); err == nil {
+		t.Fatal("expected error for an overflowing entry count")
+	}
+}
+
+// TestNewIteratorRejectsOversizedIndexLength builds an index header whose
+// declared length is far larger than the bytes actually remaining in the
+// container, and asserts NewIterator rejects it before allocating a buffer
+// sized from the unchecked declared length.
+func TestNewIteratorRejectsOversizedIndexLength(t *testing.T) {
+	var buf bytes.Buffer
+
+	indexOffset := uint64(buf.Len())
+
+	indexHeader := make([]byte, entryHeaderSize)
+	binary.LittleEndian.PutUint16(indexHeader[0:2], indexType)
+	binary.LittleEndian.PutUint32(indexHeader[2:6], 1<<31) // claims 2GiB of index data
+	buf.Write(indexHeader)
+
+	trailer := make([]byte, trailerSize)
+	binary.LittleEndian.PutUint64(trailer, indexOffset)
+	buf.Write(trailer)
+
+	if _, err := NewIterator(bytes.NewReader(buf.Bytes()), int64(buf.Len())); err == nil {
+		t.Fatal("expected error for an index length exceeding the container size")
+	}
+}
+
+// TestIteratorNextRejectsOversizedEntryLength builds a container whose index
+// points at an entry with a declared length far larger than the bytes
+// actually remaining, and asserts Next rejects it before allocating a buffer
+// sized from the unchecked declared length.
+func TestIteratorNextRejectsOversizedEntryLength(t *testing.T) {
+	var buf bytes.Buffer
+
+	entryOffset := int64(buf.Len())
+	entryHeader := make([]byte, entryHeaderSize)
+	binary.LittleEndian.PutUint16(entryHeader[0:2], 1)
+	binary.LittleEndian.PutUint32(entryHeader[2:6], 1<<31) // claims 2GiB of entry data
+	buf.Write(entryHeader)
+
+	it := &Iterator{
+		r:       bytes.NewReader(buf.Bytes()),
+		size:    int64(buf.Len()),
+		offsets: []int64{entryOffset},
+	}
+
+	if _, _, err := it.Next(); err == nil {
+		t.Fatal("expected error for an entry length exceeding the container size")
+	}
+}
@@ -0,0 +1,80 @@
+package frame
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestWriteFrameRoundTrip(t *testing.T) {
+	payload := []byte("hello world")
+	encoded := WriteFrame(payload, 7)
+
+	hdr, err := parseHeader(encoded[:headerSize])
+	if err != nil {
+		t.Fatalf("parseHeader: %v", err)
+	}
+	if hdr.Flags != 7 {
+		t.Fatalf("Flags: got %d want 7", hdr.Flags)
+	}
+	if hdr.Length != uint64(len(payload)) {
+		t.Fatalf("Length: got %d want %d", hdr.Length, len(payload))
+	}
+
+	got, err := parseBody(encoded[headerSize:], hdr.Length)
+	if err != nil {
+		t.Fatalf("parseBody: %v", err)
+	}
+	if string(got) != string(payload) {
+		t.Fatalf("payload: got %q want %q", got, payload)
+	}
+}
+
+func TestParseHeaderBadMagic(t *testing.T) {
+	encoded := WriteFrame([]byte("x"), 0)
+	encoded[0] = 'Z'
+	if _, err := parseHeader(encoded[:headerSize]); err == nil {
+		t.Fatal("expected error for bad magic")
+	}
+}
+
+func TestParseHeaderBadVersion(t *testing.T) {
+	encoded := WriteFrame([]byte("x"), 0)
+	encoded[4] = version + 1
+	if _, err := parseHeader(encoded[:headerSize]); err == nil {
+		t.Fatal("expected error for unsupported version")
+	}
+}
+
+func TestParseHeaderTooShort(t *testing.T) {
+	if _, err := parseHeader(make([]byte, headerSize-1)); err == nil {
+		t.Fatal("expected error for a truncated header")
+	}
+}
+
+func TestParseBodyCRCMismatch(t *testing.T) {
+	encoded := WriteFrame([]byte("hello"), 0)
+	encoded[len(encoded)-1] ^= 0xff
+
+	hdr, err := parseHeader(encoded[:headerSize])
+	if err != nil {
+		t.Fatalf("parseHeader: %v", err)
+	}
+	if _, err := parseBody(encoded[headerSize:], hdr.Length); err == nil {
+		t.Fatal("expected crc mismatch error")
+	}
+}
+
+func TestParseHeaderRejectsOversizedLength(t *testing.T) {
+	encoded := WriteFrame([]byte("x"), 0)
+	binary.LittleEndian.PutUint64(encoded[8:16], 1<<63) // negative once cast to int
+	if _, err := parseHeader(encoded[:headerSize]); err == nil {
+		t.Fatal("expected error for a length exceeding maxPayloadLength")
+	}
+}
+
+func TestParseBodyLengthMismatch(t *testing.T) {
+	encoded := WriteFrame([]byte("hello"), 0)
+	if _, err := parseBody(encoded[headerSize:], 4); err == nil {
+		t.Fatal("expected error for a body length inconsistent with the header")
+	}
+}
@@ -0,0 +1,35 @@
+//go:build tamago && riscv64
+
+package frame
+
+import (
+	"unsafe"
+
+	"github.com/eth-act/skunkworks-tama/tamaboards/zkvm"
+	"github.com/eth-act/skunkworks-tama/tamaboards/zkvm/zisk_runtime"
+)
+
+// ReadFrame reads and validates a frame from the host-provided input region,
+// returning the verified payload and its Header. It fails with a descriptive
+// error rather than panicking on a bad magic, unknown version, or CRC
+// mismatch.
+func ReadFrame() ([]byte, Header, error) {
+	rawHeader := make([]byte, headerSize)
+	src := unsafe.Pointer(uintptr(zkvm.INPUT_ADDR))
+	for i := 0; i < headerSize; i++ {
+		rawHeader[i] = *(*byte)(unsafe.Add(src, i))
+	}
+
+	hdr, err := parseHeader(rawHeader)
+	if err != nil {
+		return nil, Header{}, err
+	}
+
+	body := zisk_runtime.UnsafeReadBytes(headerSize + int(hdr.Length) + crcSize)
+	payload, err := parseBody(body[headerSize:], hdr.Length)
+	if err != nil {
+		return nil, Header{}, err
+	}
+
+	return payload, hdr, nil
+}
@@ -0,0 +1,43 @@
+//go:build tamago && riscv64
+
+package result
+
+import "github.com/eth-act/skunkworks-tama/tamaboards/zkvm/zisk_runtime"
+
+// Discriminant byte values committed ahead of the payload so the host can
+// branch on outcome without parsing (or failing to parse) the payload
+// itself.
+const (
+	discOk  byte = 0
+	discErr byte = 1
+)
+
+// CommitErr commits a bare failure, with no Ok type to marshal. Useful when
+// a pipeline stage fails before it ever produces a value to thread through
+// CommitResult. The committed bytes are the whole story for the host; the
+// guest has nothing left to do afterwards but return.
+func CommitErr(kind Kind) {
+	zisk_runtime.CommitBytes([]byte{discErr, byte(kind)})
+}
+
+// CommitResult commits r to the host via zisk_runtime.CommitBytes, prefixed
+// with a one-byte discriminant: discOk followed by marshal(r)'s bytes, or
+// discErr followed by the one-byte Kind code. A marshal failure on an Ok
+// value is committed as a KindDeserialize Err instead, so the host never has
+// to distinguish "guest returned Ok but we couldn't encode it" from any
+// other failure class. Either way the outcome is fully captured in what was
+// committed; the caller has nothing left to do but return.
+func CommitResult[T any](r Result[T], marshal func(T) ([]byte, error)) {
+	if r.IsErr() {
+		CommitErr(r.Error().Kind)
+		return
+	}
+
+	payload, err := marshal(r.Unwrap())
+	if err != nil {
+		CommitErr(KindDeserialize)
+		return
+	}
+
+	zisk_runtime.CommitBytes(append([]byte{discOk}, payload...))
+}
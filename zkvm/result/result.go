@@ -0,0 +1,109 @@
+// Package result provides a tagged Result type for zkVM guest programs,
+// modelled on Rust's Result<T, E>, so a guest can report a typed failure to
+// the host instead of panicking. A panic inside a proven program produces an
+// opaque proof failure; a Result lets the host distinguish bad input from a
+// user-triggered abort from a serialization bug.
+package result
+
+import "fmt"
+
+// Kind identifies the category of a guest-side failure so the host proof
+// consumer can branch on failure class without pattern-matching panic
+// strings.
+type Kind uint8
+
+const (
+	KindInvalidInput Kind = iota + 1
+	KindDeserialize
+	KindUserAbort
+	KindOverflow
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindInvalidInput:
+		return "invalid_input"
+	case KindDeserialize:
+		return "deserialize"
+	case KindUserAbort:
+		return "user_abort"
+	case KindOverflow:
+		return "overflow"
+	default:
+		return "unknown"
+	}
+}
+
+// Error is the Err payload of a Result: a failure Kind wrapping the
+// underlying cause. Is compares by Kind so callers can write
+// errors.Is(err, result.ErrOverflow) without caring about the wrapped cause.
+type Error struct {
+	Kind Kind
+	Err  error
+}
+
+func newError(kind Kind, err error) *Error {
+	return &Error{Kind: kind, Err: err}
+}
+
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return e.Kind.String()
+	}
+	return fmt.Sprintf("%s: %v", e.Kind, e.Err)
+}
+
+func (e *Error) Unwrap() error { return e.Err }
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	return t.Kind == e.Kind
+}
+
+// Sentinel errors usable with errors.Is; they carry no cause and only their
+// Kind is compared.
+var (
+	ErrInvalidInput = &Error{Kind: KindInvalidInput}
+	ErrDeserialize  = &Error{Kind: KindDeserialize}
+	ErrUserAbort    = &Error{Kind: KindUserAbort}
+	ErrOverflow     = &Error{Kind: KindOverflow}
+)
+
+// Result is a tagged Ok/Err value, mirroring Rust's Result<T, E>.
+type Result[T any] struct {
+	ok    T
+	err   *Error
+	isErr bool
+}
+
+// Ok wraps a successful value.
+func Ok[T any](v T) Result[T] {
+	return Result[T]{ok: v}
+}
+
+// Err wraps a failure of the given Kind, with err as the underlying cause.
+// err may be nil when the Kind itself is the whole story.
+func Err[T any](kind Kind, err error) Result[T] {
+	return Result[T]{err: newError(kind, err), isErr: true}
+}
+
+// IsOk reports whether r holds a success value.
+func (r Result[T]) IsOk() bool { return !r.isErr }
+
+// IsErr reports whether r holds a failure.
+func (r Result[T]) IsErr() bool { return r.isErr }
+
+// Unwrap returns the success value, panicking if r is an Err. Use only where
+// IsErr has already been checked.
+func (r Result[T]) Unwrap() T {
+	if r.isErr {
+		panic(r.err)
+	}
+	return r.ok
+}
+
+// Error returns the failure, or nil if r is Ok.
+func (r Result[T]) Error() *Error { return r.err }
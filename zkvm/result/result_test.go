@@ -0,0 +1,108 @@
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestKindString(t *testing.T) {
+	cases := map[Kind]string{
+		KindInvalidInput: "invalid_input",
+		KindDeserialize:  "deserialize",
+		KindUserAbort:    "user_abort",
+		KindOverflow:     "overflow",
+		Kind(0):          "unknown",
+	}
+	for kind, want := range cases {
+		if got := kind.String(); got != want {
+			t.Errorf("Kind(%d).String(): got %q want %q", kind, got, want)
+		}
+	}
+}
+
+func TestErrorUnwrap(t *testing.T) {
+	cause := errors.New("boom")
+	err := newError(KindDeserialize, cause)
+
+	if got := err.Unwrap(); got != cause {
+		t.Errorf("Unwrap: got %v want %v", got, cause)
+	}
+	if !errors.Is(err, cause) {
+		t.Errorf("errors.Is(err, cause): got false, want true")
+	}
+}
+
+func TestErrorErrorMessage(t *testing.T) {
+	withCause := newError(KindOverflow, errors.New("value too large"))
+	if got, want := withCause.Error(), "overflow: value too large"; got != want {
+		t.Errorf("Error(): got %q want %q", got, want)
+	}
+
+	bare := newError(KindUserAbort, nil)
+	if got, want := bare.Error(), "user_abort"; got != want {
+		t.Errorf("Error(): got %q want %q", got, want)
+	}
+}
+
+func TestErrorIsComparesByKind(t *testing.T) {
+	a := newError(KindOverflow, errors.New("first cause"))
+	b := newError(KindOverflow, errors.New("second cause"))
+	c := newError(KindUserAbort, nil)
+
+	if !errors.Is(a, b) {
+		t.Error("expected errors with the same Kind to match via errors.Is")
+	}
+	if errors.Is(a, c) {
+		t.Error("expected errors with different Kinds not to match via errors.Is")
+	}
+	if errors.Is(a, errors.New("unrelated")) {
+		t.Error("expected a plain error not to match via errors.Is")
+	}
+}
+
+func TestSentinelErrorsMatchByKind(t *testing.T) {
+	got := Err[int](KindOverflow, errors.New("overflow detail")).Error()
+	if !errors.Is(got, ErrOverflow) {
+		t.Error("expected a KindOverflow Result's Error to match ErrOverflow via errors.Is")
+	}
+	if errors.Is(got, ErrUserAbort) {
+		t.Error("expected a KindOverflow Result's Error not to match ErrUserAbort")
+	}
+}
+
+func TestOkResult(t *testing.T) {
+	r := Ok(42)
+	if !r.IsOk() || r.IsErr() {
+		t.Fatal("Ok result should report IsOk true and IsErr false")
+	}
+	if got := r.Unwrap(); got != 42 {
+		t.Fatalf("Unwrap: got %d want 42", got)
+	}
+	if r.Error() != nil {
+		t.Fatalf("Error: got %v want nil", r.Error())
+	}
+}
+
+func TestErrResult(t *testing.T) {
+	cause := errors.New("bad bytes")
+	r := Err[int](KindDeserialize, cause)
+
+	if r.IsOk() || !r.IsErr() {
+		t.Fatal("Err result should report IsOk false and IsErr true")
+	}
+	if r.Error() == nil || r.Error().Kind != KindDeserialize {
+		t.Fatalf("Error: got %v want Kind %v", r.Error(), KindDeserialize)
+	}
+	if r.Error().Unwrap() != cause {
+		t.Fatalf("Error().Unwrap(): got %v want %v", r.Error().Unwrap(), cause)
+	}
+}
+
+func TestUnwrapPanicsOnErr(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected Unwrap to panic on an Err result")
+		}
+	}()
+	Err[int](KindUserAbort, nil).Unwrap()
+}